@@ -0,0 +1,75 @@
+package enrich
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Cache stores fetched CVE metadata keyed by CVE ID, avoiding refetching
+// records that have not changed upstream. Get alone cannot detect that an
+// upstream record has since changed (it has no fresh record to compare
+// against); callers that do have one — IngestFeed, or a future
+// conditional-GET path — should use Stale instead of blindly trusting Get.
+type Cache interface {
+	// Get returns the cached metadata for cveID, if present, regardless of
+	// how old it is.
+	Get(cveID string) (*CVEMetadata, bool)
+	// Stale reports whether the cached entry for cveID is missing, or
+	// older than lastModified (a fresh record's last-modified time).
+	Stale(cveID string, lastModified time.Time) bool
+	// Put stores meta under cveID (callers pass meta.CVEID), stamped with its
+	// LastModified so a later Stale check can tell it apart from a newer
+	// upstream record.
+	Put(cveID string, meta *CVEMetadata)
+}
+
+// DiskCache persists CVEMetadata as one JSON file per CVE under Dir.
+type DiskCache struct {
+	Dir string
+}
+
+// NewDiskCache returns a DiskCache rooted at dir. dir is created on first
+// write if it does not already exist.
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{Dir: dir}
+}
+
+// Get implements Cache.
+func (c *DiskCache) Get(cveID string) (*CVEMetadata, bool) {
+	data, err := os.ReadFile(c.path(cveID))
+	if err != nil {
+		return nil, false
+	}
+	var meta CVEMetadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, false
+	}
+	return &meta, true
+}
+
+// Stale implements Cache.
+func (c *DiskCache) Stale(cveID string, lastModified time.Time) bool {
+	meta, ok := c.Get(cveID)
+	if !ok {
+		return true
+	}
+	return meta.LastModified.Before(lastModified)
+}
+
+// Put implements Cache.
+func (c *DiskCache) Put(cveID string, meta *CVEMetadata) {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(c.Dir, 0o755); err != nil {
+		return
+	}
+	_ = os.WriteFile(c.path(cveID), data, 0o644)
+}
+
+func (c *DiskCache) path(cveID string) string {
+	return filepath.Join(c.Dir, cveID+".json")
+}
@@ -0,0 +1,53 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+
+	v2entities "github.com/zavgorodnii/pentagi-taxonomy/v2/go/entities"
+)
+
+// Enricher fills missing fields on a Vulnerability from a Fetcher, without
+// overwriting fields the caller has already set.
+type Enricher struct {
+	Fetcher Fetcher
+}
+
+// NewEnricher returns an Enricher backed by the given Fetcher.
+func NewEnricher(f Fetcher) *Enricher {
+	return &Enricher{Fetcher: f}
+}
+
+// Enrich looks up v's VulnId and copies any missing Severity, CvssScore,
+// CvssVector, Title, and Exploitable fields from the fetched metadata.
+// Fields already set on v are left untouched.
+func (e *Enricher) Enrich(ctx context.Context, v *v2entities.Vulnerability) error {
+	if v.VulnId == nil || *v.VulnId == "" {
+		return fmt.Errorf("enrich: vulnerability has no vuln_id to look up")
+	}
+
+	meta, err := e.Fetcher.Fetch(ctx, *v.VulnId)
+	if err != nil {
+		return fmt.Errorf("enrich: fetch %s: %w", *v.VulnId, err)
+	}
+
+	if v.Title == nil && meta.Description != "" {
+		v.Title = &meta.Description
+	}
+	if v.Severity == nil && meta.Severity != "" {
+		v.Severity = &meta.Severity
+	}
+	if v.CvssScore == nil && meta.CvssScore != 0 {
+		score := meta.CvssScore
+		v.CvssScore = &score
+	}
+	if v.CvssVector == nil && meta.CvssVector != "" {
+		v.CvssVector = &meta.CvssVector
+	}
+	if v.Exploitable == nil && meta.Exploitable {
+		exploitable := meta.Exploitable
+		v.Exploitable = &exploitable
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+// Package enrich fills in pentagi-taxonomy Vulnerability fields from
+// authoritative external vulnerability databases such as the NVD.
+package enrich
+
+import (
+	"context"
+	"time"
+)
+
+// CVEMetadata is the normalized result of looking up a single CVE from an
+// external source.
+type CVEMetadata struct {
+	CVEID        string
+	Description  string
+	Severity     string // critical|high|medium|low|info, bucketed from CvssScore
+	CvssScore    float64
+	CvssVector   string
+	CweIDs       []string
+	References   []string
+	Exploitable  bool // true if the CVE is listed in the CISA Known Exploited Vulnerabilities catalog
+	LastModified time.Time
+}
+
+// Fetcher looks up vulnerability metadata for a single CVE.
+type Fetcher interface {
+	Fetch(ctx context.Context, cveID string) (*CVEMetadata, error)
+}
@@ -0,0 +1,255 @@
+package enrich
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// nvdAPIBase is the NVD JSON 2.0 CVE API endpoint.
+const nvdAPIBase = "https://services.nvd.nist.gov/rest/json/cves/2.0"
+
+// NVDFetcher fetches vulnerability metadata from the NVD, either on demand
+// over HTTP or in bulk from the yearly gzipped JSON feed files NVD
+// publishes (nvdcve-2.0-YYYY.json.gz).
+type NVDFetcher struct {
+	HTTPClient  *http.Client
+	Cache       Cache
+	RateLimiter RateLimiter
+}
+
+// NewNVDFetcher returns an NVDFetcher backed by cache and limiter. Both may
+// be nil: a nil cache disables caching, a nil limiter disables throttling.
+func NewNVDFetcher(cache Cache, limiter RateLimiter) *NVDFetcher {
+	if limiter == nil {
+		limiter = NoRateLimit{}
+	}
+	return &NVDFetcher{Cache: cache, RateLimiter: limiter}
+}
+
+// Fetch implements Fetcher, serving from Cache when possible and falling
+// back to a single-CVE NVD API lookup otherwise.
+func (f *NVDFetcher) Fetch(ctx context.Context, cveID string) (*CVEMetadata, error) {
+	if f.Cache != nil {
+		if cached, ok := f.Cache.Get(cveID); ok {
+			return cached, nil
+		}
+	}
+
+	if err := f.RateLimiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("enrich: rate limiter: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, nvdAPIBase+"?cveId="+url.QueryEscape(cveID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("enrich: nvd request for %s: %w", cveID, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("enrich: nvd request for %s: unexpected status %s", cveID, resp.Status)
+	}
+
+	var page nvdPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("enrich: decode nvd response for %s: %w", cveID, err)
+	}
+	if len(page.Vulnerabilities) == 0 {
+		return nil, fmt.Errorf("enrich: %s not found in nvd", cveID)
+	}
+
+	meta, err := parseNVDItem(page.Vulnerabilities[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Cache != nil {
+		f.Cache.Put(meta.CVEID, meta)
+	}
+	return meta, nil
+}
+
+// IngestFeed bulk-loads a gzipped NVD yearly feed file into the cache so
+// that later Fetch calls are served locally instead of hitting the API.
+// A record already cached with a LastModified at least as recent as the
+// feed's copy is left untouched, so re-ingesting the same feed is cheap.
+// It returns the number of CVE records ingested. IngestFeed requires a
+// non-nil Cache.
+func (f *NVDFetcher) IngestFeed(r io.Reader) (int, error) {
+	if f.Cache == nil {
+		return 0, fmt.Errorf("enrich: IngestFeed requires a Cache")
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, fmt.Errorf("enrich: open gzipped feed: %w", err)
+	}
+	defer gz.Close()
+
+	var feed nvdPage
+	if err := json.NewDecoder(gz).Decode(&feed); err != nil {
+		return 0, fmt.Errorf("enrich: decode feed: %w", err)
+	}
+
+	n := 0
+	for _, item := range feed.Vulnerabilities {
+		meta, err := parseNVDItem(item)
+		if err != nil {
+			continue
+		}
+		if !f.Cache.Stale(meta.CVEID, meta.LastModified) {
+			continue
+		}
+		f.Cache.Put(meta.CVEID, meta)
+		n++
+	}
+	return n, nil
+}
+
+func (f *NVDFetcher) httpClient() *http.Client {
+	if f.HTTPClient != nil {
+		return f.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// --- NVD JSON 2.0 wire format (subset) ---
+
+type nvdPage struct {
+	Vulnerabilities []nvdItem `json:"vulnerabilities"`
+}
+
+type nvdItem struct {
+	CVE struct {
+		ID           string `json:"id"`
+		LastModified string `json:"lastModified"`
+		// CisaExploitAdd is the date NVD added this CVE to the CISA Known
+		// Exploited Vulnerabilities catalog; present only when the CVE is
+		// actively exploited in the wild.
+		CisaExploitAdd string `json:"cisaExploitAdd"`
+		Descriptions   []struct {
+			Lang  string `json:"lang"`
+			Value string `json:"value"`
+		} `json:"descriptions"`
+		Metrics struct {
+			CvssMetricV31 []nvdCvssMetric `json:"cvssMetricV31"`
+			CvssMetricV30 []nvdCvssMetric `json:"cvssMetricV30"`
+		} `json:"metrics"`
+		Weaknesses []struct {
+			Description []struct {
+				Lang  string `json:"lang"`
+				Value string `json:"value"`
+			} `json:"description"`
+		} `json:"weaknesses"`
+		References []struct {
+			URL string `json:"url"`
+		} `json:"references"`
+	} `json:"cve"`
+}
+
+type nvdCvssMetric struct {
+	CvssData struct {
+		VectorString string  `json:"vectorString"`
+		BaseScore    float64 `json:"baseScore"`
+		BaseSeverity string  `json:"baseSeverity"`
+	} `json:"cvssData"`
+}
+
+func parseNVDItem(item nvdItem) (*CVEMetadata, error) {
+	if item.CVE.ID == "" {
+		return nil, fmt.Errorf("enrich: nvd item missing cve.id")
+	}
+
+	meta := &CVEMetadata{
+		CVEID:       item.CVE.ID,
+		Exploitable: item.CVE.CisaExploitAdd != "",
+	}
+
+	for _, d := range item.CVE.Descriptions {
+		if d.Lang == "en" {
+			meta.Description = d.Value
+			break
+		}
+	}
+
+	metric := primaryCvssMetric(item.CVE.Metrics.CvssMetricV31, item.CVE.Metrics.CvssMetricV30)
+	if metric != nil {
+		meta.CvssVector = metric.CvssData.VectorString
+		meta.CvssScore = metric.CvssData.BaseScore
+		meta.Severity = severityBucket(metric.CvssData.BaseSeverity, metric.CvssData.BaseScore)
+	}
+
+	for _, w := range item.CVE.Weaknesses {
+		for _, d := range w.Description {
+			if d.Lang == "en" && d.Value != "" {
+				meta.CweIDs = append(meta.CweIDs, d.Value)
+			}
+		}
+	}
+
+	for _, ref := range item.CVE.References {
+		meta.References = append(meta.References, ref.URL)
+	}
+
+	if item.CVE.LastModified != "" {
+		if t, err := time.Parse("2006-01-02T15:04:05.000", item.CVE.LastModified); err == nil {
+			meta.LastModified = t
+		}
+	}
+
+	return meta, nil
+}
+
+func primaryCvssMetric(candidates ...[]nvdCvssMetric) *nvdCvssMetric {
+	for _, metrics := range candidates {
+		if len(metrics) > 0 {
+			return &metrics[0]
+		}
+	}
+	return nil
+}
+
+// severityBucket maps a CVSS v3 base severity/score to the
+// critical|high|medium|low|info buckets used by entities.Vulnerability.
+func severityBucket(baseSeverity string, score float64) string {
+	if baseSeverity != "" {
+		return severityBucketString(baseSeverity)
+	}
+	switch {
+	case score >= 9.0:
+		return "critical"
+	case score >= 7.0:
+		return "high"
+	case score >= 4.0:
+		return "medium"
+	case score > 0.0:
+		return "low"
+	default:
+		return "info"
+	}
+}
+
+func severityBucketString(s string) string {
+	switch s {
+	case "CRITICAL":
+		return "critical"
+	case "HIGH":
+		return "high"
+	case "MEDIUM":
+		return "medium"
+	case "LOW":
+		return "low"
+	default:
+		return "info"
+	}
+}
@@ -0,0 +1,20 @@
+package enrich
+
+import "context"
+
+// RateLimiter throttles outbound requests made by a Fetcher. Fetch calls
+// Wait before each HTTP request (IngestFeed reads a local feed, makes no
+// request and does not call Wait); implementations can wrap
+// golang.org/x/time/rate or enforce a source's published request budget
+// (the NVD API allows 5 requests per rolling 30s without an API key, 50
+// with one).
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// NoRateLimit performs no throttling. It is useful for tests and for bulk
+// feed ingestion, which makes no outbound requests at all.
+type NoRateLimit struct{}
+
+// Wait implements RateLimiter.
+func (NoRateLimit) Wait(ctx context.Context) error { return nil }
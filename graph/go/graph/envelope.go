@@ -0,0 +1,26 @@
+package graph
+
+// Envelope is the Graphiti-compatible JSON payload used by the HTTP
+// episode ingestion path.
+type Envelope struct {
+	EntityType string         `json:"entity_type"`
+	Properties map[string]any `json:"properties"`
+	Version    int            `json:"version"`
+}
+
+// NewEnvelope builds an Envelope for n. The taxonomy schema version is
+// promoted out of n's properties into the envelope's own Version field,
+// matching how Graphiti expects version to sit alongside properties
+// rather than inside them.
+func NewEnvelope(n Node) Envelope {
+	props := n.Props()
+
+	version, _ := props["version"].(int)
+	delete(props, "version")
+
+	return Envelope{
+		EntityType: n.Label(),
+		Properties: props,
+		Version:    version,
+	}
+}
@@ -0,0 +1,68 @@
+// Package graph defines the Node/Edge contract that lets generic transport
+// code (see the neo4j subpackage) operate over any pentagi-taxonomy
+// version without depending on a specific entities package.
+package graph
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Node is implemented by every taxonomy node entity (Target, Port,
+// Vulnerability, Component, VulnerabilityException, ...), in both the v1
+// and v2 entities packages.
+type Node interface {
+	// Label is the Neo4j node label, e.g. "Target".
+	Label() string
+	// Props returns the entity's set fields keyed by their json tag name.
+	Props() map[string]any
+	// UUID is the entity's EntityUuid, or "" if unset.
+	UUID() string
+}
+
+// Edge is implemented by every taxonomy edge entity (HasPort, Discovered,
+// Affects, DependsOn, Suppresses, ...), in both the v1 and v2 entities
+// packages. Edges carry no identity of their own in the taxonomy, so
+// unlike Node they have no UUID method.
+type Edge interface {
+	// Label is the Neo4j relationship type, e.g. "HAS_PORT".
+	Label() string
+	// Props returns the edge's set fields keyed by their json tag name.
+	Props() map[string]any
+}
+
+// PropsFromTags reflects over entity (a pointer to a generated entity
+// struct) and returns its non-nil, non-empty fields keyed by their json
+// tag name. It backs the generated Props() methods in the v1 and v2
+// entities packages so the reflection logic lives in exactly one place.
+func PropsFromTags(entity any) map[string]any {
+	rv := reflect.ValueOf(entity)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	rt := rv.Type()
+
+	props := make(map[string]any, rv.NumField())
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Field(i)
+
+		switch field.Kind() {
+		case reflect.Ptr:
+			if field.IsNil() {
+				continue
+			}
+			field = field.Elem()
+		case reflect.Slice, reflect.Map:
+			if field.Len() == 0 {
+				continue
+			}
+		}
+
+		name, _, _ := strings.Cut(rt.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		props[name] = field.Interface()
+	}
+	return props
+}
@@ -0,0 +1,127 @@
+package neo4j
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+
+	graph "github.com/zavgorodnii/pentagi-taxonomy/graph/go/graph"
+)
+
+// Reader scans Neo4j query results back into typed entities using
+// reflection over their json tags.
+type Reader struct {
+	Driver neo4j.DriverWithContext
+}
+
+// NewReader returns a Reader backed by driver.
+func NewReader(driver neo4j.DriverWithContext) *Reader {
+	return &Reader{Driver: driver}
+}
+
+// ReadNodes runs query and, for each record, scans the node bound to
+// resultKey into a freshly allocated value of prototype's concrete type
+// (e.g. (*entities.Target)(nil)). The returned values share that concrete
+// type, so callers can safely type-assert them back.
+func (r *Reader) ReadNodes(ctx context.Context, query string, params map[string]any, resultKey string, prototype graph.Node) ([]graph.Node, error) {
+	session := r.Driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+
+	result, err := session.Run(ctx, query, params)
+	if err != nil {
+		return nil, fmt.Errorf("neo4j: run query: %w", err)
+	}
+
+	elemType := reflect.TypeOf(prototype).Elem()
+
+	var nodes []graph.Node
+	for result.Next(ctx) {
+		value, ok := result.Record().Get(resultKey)
+		if !ok {
+			continue
+		}
+		dbNode, ok := value.(dbtype.Node)
+		if !ok {
+			return nil, fmt.Errorf("neo4j: %q is not a node", resultKey)
+		}
+
+		entity := reflect.New(elemType)
+		scanProps(entity, dbNode.Props)
+
+		node, ok := entity.Interface().(graph.Node)
+		if !ok {
+			return nil, fmt.Errorf("neo4j: %s does not implement graph.Node", elemType)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, result.Err()
+}
+
+// scanProps sets the fields of dst (a reflect.New'd pointer to a generated
+// entity struct) from props, matching each field by its json tag name.
+func scanProps(dst reflect.Value, props map[string]any) {
+	rv := dst.Elem()
+	rt := rv.Type()
+
+	for i := 0; i < rv.NumField(); i++ {
+		name, _, _ := strings.Cut(rt.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		raw, ok := props[name]
+		if !ok {
+			continue
+		}
+		setField(rv.Field(i), raw)
+	}
+}
+
+func setField(field reflect.Value, raw any) {
+	target := field
+	if field.Kind() == reflect.Ptr {
+		target = reflect.New(field.Type().Elem()).Elem()
+	}
+
+	ok := false
+	if target.Kind() == reflect.Slice {
+		ok = setSlice(target, raw)
+	} else if rv := reflect.ValueOf(raw); rv.Type().ConvertibleTo(target.Type()) {
+		target.Set(rv.Convert(target.Type()))
+		ok = true
+	}
+	if !ok {
+		return
+	}
+
+	if field.Kind() == reflect.Ptr {
+		field.Set(target.Addr())
+	}
+}
+
+// setSlice fills target (e.g. Component.Hashes []string) from raw, which
+// the driver returns as []any rather than the target's concrete element
+// type, so each element is converted individually instead of relying on
+// whole-slice ConvertibleTo.
+func setSlice(target reflect.Value, raw any) bool {
+	rv := reflect.ValueOf(raw)
+	if rv.Kind() != reflect.Slice {
+		return false
+	}
+
+	elemType := target.Type().Elem()
+	out := reflect.MakeSlice(target.Type(), 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		elem := reflect.ValueOf(rv.Index(i).Interface())
+		if !elem.Type().ConvertibleTo(elemType) {
+			continue
+		}
+		out = reflect.Append(out, elem.Convert(elemType))
+	}
+
+	target.Set(out)
+	return true
+}
@@ -0,0 +1,61 @@
+// Package neo4j writes and reads pentagi-taxonomy graph.Node/graph.Edge
+// values to and from Neo4j over Bolt. It is entity-agnostic: it only
+// depends on the graph package's interfaces, so the same code serves both
+// the v1 and v2 entities packages without modification.
+package neo4j
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+
+	graph "github.com/zavgorodnii/pentagi-taxonomy/graph/go/graph"
+)
+
+// Writer emits parameterized MERGE Cypher for taxonomy nodes and edges.
+type Writer struct {
+	Driver neo4j.DriverWithContext
+}
+
+// NewWriter returns a Writer backed by driver.
+func NewWriter(driver neo4j.DriverWithContext) *Writer {
+	return &Writer{Driver: driver}
+}
+
+// WriteNodes MERGEs a batch of nodes, matching each on its label and uuid
+// and setting every other prop.
+func (w *Writer) WriteNodes(ctx context.Context, nodes []graph.Node) error {
+	session := w.Driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+
+	for _, n := range nodes {
+		if n.UUID() == "" {
+			return fmt.Errorf("neo4j: cannot write %s node with no uuid", n.Label())
+		}
+
+		props := n.Props()
+		query := fmt.Sprintf("MERGE (n:%s {uuid: $uuid}) SET n += $props", n.Label())
+		if _, err := session.Run(ctx, query, map[string]any{"uuid": n.UUID(), "props": props}); err != nil {
+			return fmt.Errorf("neo4j: write %s(%s): %w", n.Label(), n.UUID(), err)
+		}
+	}
+	return nil
+}
+
+// WriteEdge MERGEs a single edge between the nodes identified by fromUUID
+// and toUUID, which must already exist.
+func (w *Writer) WriteEdge(ctx context.Context, e graph.Edge, fromUUID, toUUID string) error {
+	session := w.Driver.NewSession(ctx, neo4j.SessionConfig{})
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf(
+		"MATCH (a {uuid: $from}), (b {uuid: $to}) MERGE (a)-[r:%s]->(b) SET r += $props",
+		e.Label(),
+	)
+	params := map[string]any{"from": fromUUID, "to": toUUID, "props": e.Props()}
+	if _, err := session.Run(ctx, query, params); err != nil {
+		return fmt.Errorf("neo4j: write %s(%s -> %s): %w", e.Label(), fromUUID, toUUID, err)
+	}
+	return nil
+}
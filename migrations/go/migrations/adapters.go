@@ -0,0 +1,199 @@
+package migrations
+
+import (
+	"fmt"
+
+	v1entities "github.com/zavgorodnii/pentagi-taxonomy/v1/go/entities"
+	v2entities "github.com/zavgorodnii/pentagi-taxonomy/v2/go/entities"
+)
+
+func init() {
+	register("Target", 1,
+		func(e any) (any, *ConversionError, error) { return upgradeTarget(e.(*v1entities.Target)) },
+		func(e any) (any, *ConversionError, error) { return downgradeTarget(e.(*v2entities.Target)) },
+	)
+	register("Port", 1,
+		func(e any) (any, *ConversionError, error) { return upgradePort(e.(*v1entities.Port)) },
+		func(e any) (any, *ConversionError, error) { return downgradePort(e.(*v2entities.Port)) },
+	)
+	register("HasPort", 1,
+		func(e any) (any, *ConversionError, error) { return upgradeHasPort(e.(*v1entities.HasPort)) },
+		func(e any) (any, *ConversionError, error) { return downgradeHasPort(e.(*v2entities.HasPort)) },
+	)
+	register("Discovered", 1,
+		func(e any) (any, *ConversionError, error) { return upgradeDiscovered(e.(*v1entities.Discovered)) },
+		func(e any) (any, *ConversionError, error) { return downgradeDiscovered(e.(*v2entities.Discovered)) },
+	)
+	register("Affects", 1,
+		nil, // Affects was introduced in v2; there is nothing to upgrade from v1
+		func(e any) (any, *ConversionError, error) { return downgradeAffects(e.(*v2entities.Affects)) },
+	)
+}
+
+// typeNameAndVersion returns the registry key for entity and the taxonomy
+// version its concrete Go type belongs to (1 for a v1entities type, 2 for
+// a v2entities type), or "" / 0 if entity is not a type this package knows
+// how to migrate. Upgrade uses the version to catch a caller passing the
+// wrong fromVersion for the concrete type it handed in, before any step
+// function gets a chance to assert on it.
+func typeNameAndVersion(entity any) (name string, version int) {
+	switch entity.(type) {
+	case *v1entities.Target:
+		return "Target", 1
+	case *v2entities.Target:
+		return "Target", 2
+	case *v1entities.Port:
+		return "Port", 1
+	case *v2entities.Port:
+		return "Port", 2
+	case *v1entities.HasPort:
+		return "HasPort", 1
+	case *v2entities.HasPort:
+		return "HasPort", 2
+	case *v1entities.Discovered:
+		return "Discovered", 1
+	case *v2entities.Discovered:
+		return "Discovered", 2
+	case *v2entities.Affects:
+		return "Affects", 2
+	default:
+		return "", 0
+	}
+}
+
+// MigrateTarget converts a v1 Target to a v2 Target.
+func MigrateTarget(t *v1entities.Target) (*v2entities.Target, *ConversionError, error) {
+	return upgradeTarget(t)
+}
+
+// DowngradeTarget converts a v2 Target to a v1 Target.
+func DowngradeTarget(t *v2entities.Target) (*v1entities.Target, *ConversionError, error) {
+	return downgradeTarget(t)
+}
+
+// upgradeTarget converts a v1 Target to v2. DiscoveredAt is left unset: v1
+// Target carries no timestamp field to source it from, so there is no
+// record timestamp to default it to.
+func upgradeTarget(t *v1entities.Target) (*v2entities.Target, *ConversionError, error) {
+	return &v2entities.Target{
+		Version:    intPtr(2),
+		EntityUuid: t.EntityUuid,
+		Hostname:   t.Hostname,
+		IpAddress:  t.IpAddress,
+		TargetType: t.TargetType,
+		RiskScore:  t.RiskScore,
+		Status:     t.Status,
+		// DiscoveredAt did not exist in v1; it is left unset rather than
+		// guessed at.
+	}, nil, nil
+}
+
+func downgradeTarget(t *v2entities.Target) (*v1entities.Target, *ConversionError, error) {
+	out := &v1entities.Target{
+		Version:    intPtr(1),
+		EntityUuid: t.EntityUuid,
+		Hostname:   t.Hostname,
+		IpAddress:  t.IpAddress,
+		TargetType: t.TargetType,
+		RiskScore:  t.RiskScore,
+		Status:     t.Status,
+	}
+
+	var lossy []LossyField
+	if t.Status != nil && *t.Status == "scanning" {
+		active := "active"
+		out.Status = &active
+		lossy = append(lossy, LossyField{Field: "status", Reason: "scanning has no v1 equivalent; coerced to active"})
+	}
+	if t.TargetType != nil && *t.TargetType == "domain" {
+		out.TargetType = nil
+		lossy = append(lossy, LossyField{Field: "target_type", Reason: "domain has no v1 equivalent; cleared"})
+	}
+	if t.DiscoveredAt != nil {
+		lossy = append(lossy, LossyField{Field: "discovered_at", Reason: "v1 Target has no discovered_at field"})
+	}
+
+	if len(lossy) > 0 {
+		return out, &ConversionError{Lossy: lossy}, nil
+	}
+	return out, nil, nil
+}
+
+// MigratePort converts a v1 Port to a v2 Port.
+func MigratePort(p *v1entities.Port) (*v2entities.Port, *ConversionError, error) {
+	return upgradePort(p)
+}
+
+// DowngradePort converts a v2 Port to a v1 Port.
+func DowngradePort(p *v2entities.Port) (*v1entities.Port, *ConversionError, error) {
+	return downgradePort(p)
+}
+
+// upgradePort converts a v1 Port to v2. DiscoveredAt is left unset: v1 Port
+// carries no timestamp field to source it from, so there is no record
+// timestamp to default it to.
+func upgradePort(p *v1entities.Port) (*v2entities.Port, *ConversionError, error) {
+	return &v2entities.Port{
+		Version:    intPtr(2),
+		EntityUuid: p.EntityUuid,
+		PortNumber: p.PortNumber,
+		Protocol:   p.Protocol,
+		State:      p.State,
+		// DiscoveredAt did not exist in v1; it is left unset rather than
+		// guessed at.
+	}, nil, nil
+}
+
+func downgradePort(p *v2entities.Port) (*v1entities.Port, *ConversionError, error) {
+	out := &v1entities.Port{
+		Version:    intPtr(1),
+		EntityUuid: p.EntityUuid,
+		PortNumber: p.PortNumber,
+		Protocol:   p.Protocol,
+		State:      p.State,
+	}
+
+	var lossy []LossyField
+	if p.DiscoveredAt != nil {
+		lossy = append(lossy, LossyField{Field: "discovered_at", Reason: "v1 Port has no discovered_at field"})
+	}
+
+	if len(lossy) > 0 {
+		return out, &ConversionError{Lossy: lossy}, nil
+	}
+	return out, nil, nil
+}
+
+func upgradeHasPort(e *v1entities.HasPort) (*v2entities.HasPort, *ConversionError, error) {
+	return &v2entities.HasPort{Version: intPtr(2), Timestamp: e.Timestamp}, nil, nil
+}
+
+func downgradeHasPort(e *v2entities.HasPort) (*v1entities.HasPort, *ConversionError, error) {
+	return &v1entities.HasPort{Version: intPtr(1), Timestamp: e.Timestamp}, nil, nil
+}
+
+func upgradeDiscovered(e *v1entities.Discovered) (*v2entities.Discovered, *ConversionError, error) {
+	return &v2entities.Discovered{
+		Version:    intPtr(2),
+		Timestamp:  e.Timestamp,
+		Confidence: e.Confidence,
+		Method:     e.Method,
+	}, nil, nil
+}
+
+func downgradeDiscovered(e *v2entities.Discovered) (*v1entities.Discovered, *ConversionError, error) {
+	return &v1entities.Discovered{
+		Version:    intPtr(1),
+		Timestamp:  e.Timestamp,
+		Confidence: e.Confidence,
+		Method:     e.Method,
+	}, nil, nil
+}
+
+// downgradeAffects always fails: Affects was introduced in v2 and has no
+// v1 representation at all, so there is nothing meaningful to return.
+func downgradeAffects(e *v2entities.Affects) (any, *ConversionError, error) {
+	return nil, nil, fmt.Errorf("migrations: Affects has no v1 representation")
+}
+
+func intPtr(i int) *int { return &i }
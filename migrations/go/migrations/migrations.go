@@ -0,0 +1,128 @@
+// Package migrations converts pentagi-taxonomy entities and edges between
+// schema versions.
+//
+// Each vN -> vN+1 jump is implemented as a single-step migrator registered
+// in adapters.go; Upgrade composes those steps, in either direction, so
+// callers never need to reason about intermediate versions. When a new taxonomy version is added,
+// only the single-step migrators for that version need to be written.
+package migrations
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// LossyField describes a field that could not be carried across a version
+// boundary without loss of information.
+type LossyField struct {
+	Field  string // field name, as it appears in the entity's json tag
+	Reason string // why the field was dropped, defaulted, or coerced
+}
+
+// ConversionError reports that a migration completed but dropped or
+// approximated one or more fields. The converted value is still returned
+// alongside this error so callers can choose to log it, surface it, or
+// refuse the conversion outright.
+type ConversionError struct {
+	Lossy []LossyField
+}
+
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("migrations: %d field(s) lost in conversion", len(e.Lossy))
+}
+
+// step migrates a single entity or edge across exactly one version
+// boundary, returning the converted value and, if some fields could not be
+// carried over cleanly, a non-nil ConversionError.
+type step func(entity any) (any, *ConversionError, error)
+
+type stepKey struct {
+	typeName string
+	from     int
+}
+
+// upSteps[key] migrates typeName from version key.from to key.from+1.
+var upSteps = map[stepKey]step{}
+
+// downSteps[key] migrates typeName from version key.from to key.from-1.
+var downSteps = map[stepKey]step{}
+
+func register(typeName string, from int, up, down step) {
+	if up != nil {
+		upSteps[stepKey{typeName, from}] = up
+	}
+	if down != nil {
+		downSteps[stepKey{typeName, from + 1}] = down
+	}
+}
+
+// Upgrade converts entity from fromVersion to toVersion, chaining
+// single-step migrators through any intermediate versions. toVersion may be
+// lower than fromVersion, in which case entity is downgraded.
+//
+// fromVersion is validated against both entity's concrete Go type and its
+// Version field before any migrator runs; a mismatch is a caller error and
+// is reported, not panicked on.
+//
+// The returned error is a *ConversionError when the conversion succeeded
+// but some fields were dropped, defaulted, or coerced along the way;
+// callers that must not lose data can type-assert for it and refuse the
+// result.
+func Upgrade(fromVersion, toVersion int, entity any) (any, error) {
+	typeName, concreteVersion := typeNameAndVersion(entity)
+	if typeName == "" {
+		return nil, fmt.Errorf("migrations: unsupported entity type %T", entity)
+	}
+	if concreteVersion != fromVersion {
+		return nil, fmt.Errorf("migrations: entity is a v%d %s, not v%d as fromVersion claims", concreteVersion, typeName, fromVersion)
+	}
+	if v, ok := versionField(entity); ok && v != nil && *v != fromVersion {
+		return nil, fmt.Errorf("migrations: %s.Version is %d, not v%d as fromVersion claims", typeName, *v, fromVersion)
+	}
+	if fromVersion == toVersion {
+		return entity, nil
+	}
+
+	steps, step := upSteps, 1
+	if toVersion < fromVersion {
+		steps, step = downSteps, -1
+	}
+
+	current := entity
+	var lossy []LossyField
+	for v := fromVersion; v != toVersion; v += step {
+		fn, ok := steps[stepKey{typeName, v}]
+		if !ok {
+			return nil, fmt.Errorf("migrations: no migrator registered to move %s from v%d to v%d", typeName, v, v+step)
+		}
+		next, convErr, err := fn(current)
+		if err != nil {
+			return nil, err
+		}
+		if convErr != nil {
+			lossy = append(lossy, convErr.Lossy...)
+		}
+		current = next
+	}
+
+	if len(lossy) > 0 {
+		return current, &ConversionError{Lossy: lossy}
+	}
+	return current, nil
+}
+
+// versionField reads entity's Version field, which every generated
+// entity/edge struct carries as *int. ok is false if entity is not a
+// pointer to a struct with such a field.
+func versionField(entity any) (v *int, ok bool) {
+	rv := reflect.ValueOf(entity)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+	field := rv.Elem().FieldByName("Version")
+	if !field.IsValid() {
+		return nil, false
+	}
+	v, ok = field.Interface().(*int)
+	return v, ok
+}
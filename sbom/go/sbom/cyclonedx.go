@@ -0,0 +1,362 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	v2entities "github.com/zavgorodnii/pentagi-taxonomy/v2/go/entities"
+)
+
+// --- CycloneDX 1.5 JSON wire format (subset) ---
+
+type cdxBOM struct {
+	BOMFormat       string             `json:"bomFormat"`
+	SpecVersion     string             `json:"specVersion"`
+	Metadata        *cdxMetadata       `json:"metadata,omitempty"`
+	Components      []cdxComponent     `json:"components,omitempty"`
+	Dependencies    []cdxDependency    `json:"dependencies,omitempty"`
+	Vulnerabilities []cdxVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+type cdxMetadata struct {
+	Component *cdxComponent `json:"component,omitempty"`
+}
+
+type cdxComponent struct {
+	BOMRef   string             `json:"bom-ref,omitempty"`
+	Type     string             `json:"type"`
+	Name     string             `json:"name"`
+	Version  string             `json:"version,omitempty"`
+	Purl     string             `json:"purl,omitempty"`
+	CPE      string             `json:"cpe,omitempty"`
+	Hashes   []cdxHash          `json:"hashes,omitempty"`
+	Licenses []cdxLicenseChoice `json:"licenses,omitempty"`
+}
+
+type cdxHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cdxLicenseChoice struct {
+	License    *cdxLicense `json:"license,omitempty"`
+	Expression string      `json:"expression,omitempty"`
+}
+
+type cdxLicense struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type cdxDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+type cdxVulnerability struct {
+	ID      string           `json:"id"`
+	Affects []cdxVulnAffects `json:"affects,omitempty"`
+}
+
+type cdxVulnAffects struct {
+	Ref string `json:"ref"`
+}
+
+// ImportCycloneDX parses a CycloneDX JSON document into a Graph. Nested
+// component hierarchies are preserved through DependsOn edges, components
+// are deduplicated by purl (falling back to bom-ref), and every imported
+// component is linked to the graph via a Discovered edge with
+// method=passive so downstream ingestion can tell SBOM-derived knowledge
+// apart from live scan results.
+func ImportCycloneDX(r io.Reader) (*Graph, error) {
+	var bom cdxBOM
+	if err := json.NewDecoder(r).Decode(&bom); err != nil {
+		return nil, fmt.Errorf("sbom: decode cyclonedx json: %w", err)
+	}
+	if bom.BOMFormat != "" && bom.BOMFormat != "CycloneDX" {
+		return nil, fmt.Errorf("sbom: not a CycloneDX document (bomFormat=%q)", bom.BOMFormat)
+	}
+
+	g := &Graph{}
+	byKey := map[string]*v2entities.Component{} // purl or bom-ref -> component, for dedup
+	byRef := map[string]*v2entities.Component{} // bom-ref -> component
+
+	addComponent := func(c cdxComponent) *v2entities.Component {
+		key := c.Purl
+		if key == "" {
+			key = c.BOMRef
+		}
+		if existing, ok := byKey[key]; ok && key != "" {
+			byRef[c.BOMRef] = existing
+			return existing
+		}
+
+		comp := componentFromCDX(c)
+		if key != "" {
+			byKey[key] = comp
+		}
+		byRef[c.BOMRef] = comp
+		g.Components = append(g.Components, comp)
+		g.Discovered = append(g.Discovered, DiscoveredEdge{
+			From: "sbom-import",
+			To:   *comp.EntityUuid,
+			Edge: &v2entities.Discovered{Version: intPtr(2), Method: strPtr("passive")},
+		})
+		return comp
+	}
+
+	if bom.Metadata != nil && bom.Metadata.Component != nil {
+		root := addComponent(*bom.Metadata.Component)
+		g.Target = &v2entities.Target{
+			Version:    intPtr(2),
+			EntityUuid: root.EntityUuid,
+			Hostname:   root.Name,
+		}
+	}
+
+	for _, c := range bom.Components {
+		addComponent(c)
+	}
+
+	for _, dep := range bom.Dependencies {
+		from, ok := byRef[dep.Ref]
+		if !ok {
+			continue
+		}
+		for _, toRef := range dep.DependsOn {
+			to, ok := byRef[toRef]
+			if !ok {
+				continue
+			}
+			g.DependsOn = append(g.DependsOn, DependsOnEdge{
+				From: *from.EntityUuid,
+				To:   *to.EntityUuid,
+				Edge: &v2entities.DependsOn{Version: intPtr(2)},
+			})
+		}
+	}
+
+	byVulnID := map[string]*v2entities.Vulnerability{}
+	for _, vuln := range bom.Vulnerabilities {
+		v, ok := byVulnID[vuln.ID]
+		if !ok {
+			v = &v2entities.Vulnerability{
+				Version:    intPtr(2),
+				EntityUuid: strPtr(vuln.ID),
+				VulnId:     strPtr(vuln.ID),
+			}
+			byVulnID[vuln.ID] = v
+			g.Vulnerabilities = append(g.Vulnerabilities, v)
+		}
+
+		for _, affected := range vuln.Affects {
+			comp, ok := byRef[affected.Ref]
+			if !ok {
+				continue
+			}
+			g.Affects = append(g.Affects, AffectsEdge{
+				From: *v.EntityUuid,
+				To:   *comp.EntityUuid,
+				Edge: &v2entities.Affects{Version: intPtr(2), Impact: strPtr("direct")},
+			})
+		}
+	}
+
+	return g, nil
+}
+
+func componentFromCDX(c cdxComponent) *v2entities.Component {
+	uuid := c.Purl
+	if uuid == "" {
+		uuid = c.BOMRef
+	}
+
+	comp := &v2entities.Component{
+		Version:    intPtr(2),
+		EntityUuid: strPtr(uuid),
+		Classifier: strPtr(normalizeClassifier(c.Type)),
+		Name:       strPtr(c.Name),
+	}
+	if c.Version != "" {
+		comp.ComponentVersion = strPtr(c.Version)
+	}
+	if c.Purl != "" {
+		comp.Purl = strPtr(c.Purl)
+	}
+	if c.CPE != "" {
+		comp.Cpe = strPtr(c.CPE)
+	}
+	for _, h := range c.Hashes {
+		comp.Hashes = append(comp.Hashes, h.Alg+":"+h.Content)
+	}
+	for _, lic := range c.Licenses {
+		switch {
+		case lic.License != nil && lic.License.ID != "":
+			comp.Licenses = append(comp.Licenses, lic.License.ID)
+		case lic.License != nil && lic.License.Name != "":
+			comp.Licenses = append(comp.Licenses, lic.License.Name)
+		case lic.Expression != "":
+			comp.Licenses = append(comp.Licenses, lic.Expression)
+		}
+	}
+	return comp
+}
+
+// isSPDXLicenseExpression reports whether lic looks like an SPDX license
+// expression (e.g. "MIT OR Apache-2.0") rather than a single SPDX license
+// identifier or free-form name. Component.Licenses doesn't tag which form
+// a value originally came from, so this is a heuristic: identifiers don't
+// contain the spaces or parentheses an expression's AND/OR/WITH operators
+// and grouping require. A free-form license name that contains a space or
+// parenthesis is also reported as an expression.
+func isSPDXLicenseExpression(lic string) bool {
+	return strings.ContainsAny(lic, " ()")
+}
+
+// normalizeClassifier maps a CycloneDX component type onto the oneof
+// values of Component.Classifier (CycloneDX uses a hyphen in
+// "operating-system", the taxonomy uses an underscore). Every other type is
+// returned unchanged, so CycloneDX types with no Classifier equivalent
+// (e.g. "platform") fall outside the oneof values.
+func normalizeClassifier(t string) string {
+	if t == "operating-system" {
+		return "operating_system"
+	}
+	return t
+}
+
+func denormalizeClassifier(c string) string {
+	if c == "operating_system" {
+		return "operating-system"
+	}
+	return c
+}
+
+// ExportCycloneDX serializes g back to a CycloneDX 1.5 JSON document,
+// symmetric with ImportCycloneDX: components and their dependency
+// hierarchy, the root Target as metadata.component, and VEX-style
+// vulnerabilities/affects are all re-emitted.
+func ExportCycloneDX(g *Graph) ([]byte, error) {
+	bom := cdxBOM{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+	}
+
+	byUUID := make(map[string]*v2entities.Component, len(g.Components))
+	refOf := make(map[string]string, len(g.Components))
+	for _, c := range g.Components {
+		byUUID[*c.EntityUuid] = c
+		refOf[*c.EntityUuid] = componentRef(c)
+		bom.Components = append(bom.Components, componentToCDX(c, refOf[*c.EntityUuid]))
+	}
+
+	if g.Target != nil && g.Target.EntityUuid != nil {
+		if root, ok := byUUID[*g.Target.EntityUuid]; ok {
+			rootCDX := componentToCDX(root, refOf[*root.EntityUuid])
+			bom.Metadata = &cdxMetadata{Component: &rootCDX}
+		}
+	}
+
+	depsByRef := map[string][]string{}
+	var depOrder []string
+	for _, d := range g.DependsOn {
+		from, ok := byUUID[d.From]
+		if !ok {
+			continue
+		}
+		to, ok := byUUID[d.To]
+		if !ok {
+			continue
+		}
+		fromRef := refOf[*from.EntityUuid]
+		if _, seen := depsByRef[fromRef]; !seen {
+			depOrder = append(depOrder, fromRef)
+		}
+		depsByRef[fromRef] = append(depsByRef[fromRef], refOf[*to.EntityUuid])
+	}
+	for _, ref := range depOrder {
+		bom.Dependencies = append(bom.Dependencies, cdxDependency{Ref: ref, DependsOn: depsByRef[ref]})
+	}
+
+	vulnByUUID := make(map[string]*v2entities.Vulnerability, len(g.Vulnerabilities))
+	affectsByVuln := map[string][]cdxVulnAffects{}
+	var vulnOrder []string
+	for _, v := range g.Vulnerabilities {
+		vulnByUUID[*v.EntityUuid] = v
+		// Register every vulnerability up front so one with no Affects
+		// edge is still re-emitted.
+		if v.VulnId != nil {
+			if _, seen := affectsByVuln[*v.VulnId]; !seen {
+				vulnOrder = append(vulnOrder, *v.VulnId)
+				affectsByVuln[*v.VulnId] = nil
+			}
+		}
+	}
+
+	for _, a := range g.Affects {
+		vuln, ok := vulnByUUID[a.From]
+		if !ok || vuln.VulnId == nil {
+			continue
+		}
+		comp, ok := byUUID[a.To]
+		if !ok {
+			continue
+		}
+		vulnID := *vuln.VulnId
+		if _, seen := affectsByVuln[vulnID]; !seen {
+			vulnOrder = append(vulnOrder, vulnID)
+		}
+		ref := refOf[*comp.EntityUuid]
+		affectsByVuln[vulnID] = append(affectsByVuln[vulnID], cdxVulnAffects{Ref: ref})
+	}
+	for _, vulnID := range vulnOrder {
+		bom.Vulnerabilities = append(bom.Vulnerabilities, cdxVulnerability{
+			ID:      vulnID,
+			Affects: affectsByVuln[vulnID],
+		})
+	}
+
+	return json.MarshalIndent(bom, "", "  ")
+}
+
+func componentRef(c *v2entities.Component) string {
+	if c.Purl != nil && *c.Purl != "" {
+		return *c.Purl
+	}
+	return *c.EntityUuid
+}
+
+func componentToCDX(c *v2entities.Component, ref string) cdxComponent {
+	out := cdxComponent{BOMRef: ref}
+	if c.Classifier != nil {
+		out.Type = denormalizeClassifier(*c.Classifier)
+	}
+	if c.Name != nil {
+		out.Name = *c.Name
+	}
+	if c.ComponentVersion != nil {
+		out.Version = *c.ComponentVersion
+	}
+	if c.Purl != nil {
+		out.Purl = *c.Purl
+	}
+	if c.Cpe != nil {
+		out.CPE = *c.Cpe
+	}
+	for _, h := range c.Hashes {
+		alg, content, ok := strings.Cut(h, ":")
+		if ok {
+			out.Hashes = append(out.Hashes, cdxHash{Alg: alg, Content: content})
+		}
+	}
+	for _, lic := range c.Licenses {
+		if isSPDXLicenseExpression(lic) {
+			out.Licenses = append(out.Licenses, cdxLicenseChoice{Expression: lic})
+			continue
+		}
+		out.Licenses = append(out.Licenses, cdxLicenseChoice{License: &cdxLicense{ID: lic}})
+	}
+	return out
+}
@@ -0,0 +1,47 @@
+// Package sbom imports CycloneDX and SPDX software bills of material into
+// a pentagi-taxonomy graph of Components rooted at a Target.
+package sbom
+
+import v2entities "github.com/zavgorodnii/pentagi-taxonomy/v2/go/entities"
+
+// Graph is an in-memory taxonomy graph produced by an SBOM import. Edge
+// structs only carry properties (matching the entities package), so each
+// relationship here pairs the edge with the EntityUuid of the nodes it
+// connects.
+type Graph struct {
+	Target          *v2entities.Target
+	Components      []*v2entities.Component
+	Vulnerabilities []*v2entities.Vulnerability
+	DependsOn       []DependsOnEdge
+	Discovered      []DiscoveredEdge
+	Affects         []AffectsEdge
+}
+
+// DependsOnEdge is a DependsOn relationship between two components,
+// identified by EntityUuid.
+type DependsOnEdge struct {
+	From string // dependent component's EntityUuid
+	To   string // dependency component's EntityUuid
+	Edge *v2entities.DependsOn
+}
+
+// DiscoveredEdge is a Discovered relationship produced by the SBOM import
+// itself, identified by EntityUuid. From is a synthetic source identifier
+// (e.g. "sbom-import") rather than another node's UUID.
+type DiscoveredEdge struct {
+	From string
+	To   string // discovered node's EntityUuid
+	Edge *v2entities.Discovered
+}
+
+// AffectsEdge is an Affects relationship between a vulnerability and a
+// component or target, identified by EntityUuid.
+type AffectsEdge struct {
+	From string // vulnerability's EntityUuid
+	To   string // affected component or target's EntityUuid
+	Edge *v2entities.Affects
+}
+
+func strPtr(s string) *string { return &s }
+
+func intPtr(i int) *int { return &i }
@@ -0,0 +1,161 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	v2entities "github.com/zavgorodnii/pentagi-taxonomy/v2/go/entities"
+)
+
+// --- SPDX 2.3 JSON wire format (subset) ---
+
+type spdxDocument struct {
+	SPDXID        string             `json:"SPDXID"`
+	Packages      []spdxPackage      `json:"packages"`
+	Relationships []spdxRelationship `json:"relationships"`
+}
+
+type spdxPackage struct {
+	SPDXID                string            `json:"SPDXID"`
+	Name                  string            `json:"name"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose,omitempty"`
+	ExternalRefs          []spdxExternalRef `json:"externalRefs,omitempty"`
+	Checksums             []spdxChecksum    `json:"checksums,omitempty"`
+	LicenseConcluded      string            `json:"licenseConcluded,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelatedSpdxElement string `json:"relatedSpdxElement"`
+	RelationshipType   string `json:"relationshipType"`
+}
+
+// ImportSPDX parses an SPDX 2.3 JSON document into a Graph. "DEPENDS_ON"
+// relationships become DependsOn edges, the package named by a
+// "DESCRIBES" relationship from the document (falling back to the first
+// package) becomes the graph's Target, and every imported package is
+// linked to the graph via a Discovered edge with method=passive.
+func ImportSPDX(r io.Reader) (*Graph, error) {
+	var doc spdxDocument
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("sbom: decode spdx json: %w", err)
+	}
+	if len(doc.Packages) == 0 {
+		return nil, fmt.Errorf("sbom: spdx document has no packages")
+	}
+
+	g := &Graph{}
+	byID := make(map[string]*v2entities.Component, len(doc.Packages))
+
+	for _, p := range doc.Packages {
+		comp := componentFromSPDX(p)
+		byID[p.SPDXID] = comp
+		g.Components = append(g.Components, comp)
+		g.Discovered = append(g.Discovered, DiscoveredEdge{
+			From: "sbom-import",
+			To:   *comp.EntityUuid,
+			Edge: &v2entities.Discovered{Version: intPtr(2), Method: strPtr("passive")},
+		})
+	}
+
+	rootID := doc.Packages[0].SPDXID
+	for _, rel := range doc.Relationships {
+		relType := strings.ToUpper(rel.RelationshipType)
+		switch relType {
+		case "DESCRIBES":
+			if rel.SPDXElementID == doc.SPDXID {
+				rootID = rel.RelatedSpdxElement
+			}
+		case "DEPENDS_ON":
+			from, ok := byID[rel.SPDXElementID]
+			if !ok {
+				continue
+			}
+			to, ok := byID[rel.RelatedSpdxElement]
+			if !ok {
+				continue
+			}
+			g.DependsOn = append(g.DependsOn, DependsOnEdge{
+				From: *from.EntityUuid,
+				To:   *to.EntityUuid,
+				Edge: &v2entities.DependsOn{Version: intPtr(2)},
+			})
+		}
+	}
+
+	if root, ok := byID[rootID]; ok {
+		g.Target = &v2entities.Target{
+			Version:    intPtr(2),
+			EntityUuid: root.EntityUuid,
+			Hostname:   root.Name,
+		}
+	}
+
+	return g, nil
+}
+
+func componentFromSPDX(p spdxPackage) *v2entities.Component {
+	uuid := p.SPDXID
+	comp := &v2entities.Component{
+		Version:    intPtr(2),
+		EntityUuid: strPtr(uuid),
+		Classifier: strPtr(classifierFromSPDXPurpose(p.PrimaryPackagePurpose)),
+		Name:       strPtr(p.Name),
+	}
+	if p.VersionInfo != "" {
+		comp.ComponentVersion = strPtr(p.VersionInfo)
+	}
+	if p.LicenseConcluded != "" && p.LicenseConcluded != "NOASSERTION" {
+		comp.Licenses = append(comp.Licenses, p.LicenseConcluded)
+	}
+	for _, ref := range p.ExternalRefs {
+		switch ref.ReferenceType {
+		case "purl":
+			comp.Purl = strPtr(ref.ReferenceLocator)
+		case "cpe23Type", "cpe22Type":
+			comp.Cpe = strPtr(ref.ReferenceLocator)
+		}
+	}
+	for _, cs := range p.Checksums {
+		comp.Hashes = append(comp.Hashes, cs.Algorithm+":"+cs.ChecksumValue)
+	}
+	return comp
+}
+
+// classifierFromSPDXPurpose maps SPDX's primaryPackagePurpose onto the
+// oneof values of Component.Classifier, defaulting to "library" when the
+// field is absent (SPDX does not require it).
+func classifierFromSPDXPurpose(purpose string) string {
+	switch strings.ToUpper(purpose) {
+	case "APPLICATION":
+		return "application"
+	case "FRAMEWORK":
+		return "framework"
+	case "CONTAINER":
+		return "container"
+	case "OPERATING-SYSTEM", "OPERATING_SYSTEM":
+		return "operating_system"
+	case "DEVICE":
+		return "device"
+	case "FIRMWARE":
+		return "firmware"
+	case "FILE":
+		return "file"
+	default:
+		return "library"
+	}
+}
@@ -0,0 +1,135 @@
+// Package suppress evaluates VulnerabilityExceptions against findings and
+// recomputes Target risk once suppressed vulnerabilities are removed.
+package suppress
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	v2entities "github.com/zavgorodnii/pentagi-taxonomy/v2/go/entities"
+)
+
+// Evaluator matches vulnerabilities against a fixed set of
+// VulnerabilityExceptions. It holds no mutable state, so Match is
+// idempotent: calling it twice with the same arguments at the same Now()
+// instant always returns the same result (expiry depends on Now).
+type Evaluator struct {
+	Exceptions []*v2entities.VulnerabilityException
+	// Now returns the current time; overridable so tests can pin expiry
+	// checks to a fixed instant. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewEvaluator returns an Evaluator over exceptions.
+func NewEvaluator(exceptions []*v2entities.VulnerabilityException) *Evaluator {
+	return &Evaluator{Exceptions: exceptions}
+}
+
+// Match returns every exception that currently suppresses v on target
+// (nil if target is not relevant to the match), and whether any matched.
+//
+// Match has no affected component to check a Scope pattern against, so
+// exceptions scoped to a component name or purl never match here; use
+// MatchComponent for those.
+func (e *Evaluator) Match(v *v2entities.Vulnerability, target *v2entities.Target) ([]*v2entities.VulnerabilityException, bool) {
+	return e.MatchComponent(v, target, nil)
+}
+
+// MatchComponent is Match, but also matches exceptions whose Scope is a
+// component name or purl pattern against component (pass nil if the
+// finding isn't component-scoped, e.g. a host-level vulnerability).
+func (e *Evaluator) MatchComponent(v *v2entities.Vulnerability, target *v2entities.Target, component *v2entities.Component) ([]*v2entities.VulnerabilityException, bool) {
+	now := e.now()
+
+	var matched []*v2entities.VulnerabilityException
+	for _, ex := range e.Exceptions {
+		if matches(ex, v, target, component, now) {
+			matched = append(matched, ex)
+		}
+	}
+	return matched, len(matched) > 0
+}
+
+func (e *Evaluator) now() time.Time {
+	if e.Now != nil {
+		return e.Now()
+	}
+	return time.Now()
+}
+
+func matches(ex *v2entities.VulnerabilityException, v *v2entities.Vulnerability, target *v2entities.Target, component *v2entities.Component, now time.Time) bool {
+	if ex.ExpiresAt != nil && !now.Before(time.Unix(int64(*ex.ExpiresAt), 0)) {
+		return false
+	}
+	if !severityMatches(ex.Severities, v.Severity) {
+		return false
+	}
+	if ex.FixableOnly != nil && *ex.FixableOnly {
+		// The taxonomy has no "fix available" field yet, so a fixable-only
+		// exception can never be positively confirmed; fail closed rather
+		// than suppress a finding we can't prove is fixable.
+		return false
+	}
+	if ex.Scope == nil || *ex.Scope == "" {
+		return true
+	}
+	return scopeMatches(*ex.Scope, v, target, component)
+}
+
+func severityMatches(allowed []string, severity *string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	if severity == nil {
+		return false
+	}
+	for _, s := range allowed {
+		if s == *severity {
+			return true
+		}
+	}
+	return false
+}
+
+// scopeMatches checks pattern (a shell glob, or a /regexp/ when wrapped in
+// slashes) against the vulnerability's vuln_id, the target's hostname, and
+// (when component is non-nil) the affected component's name and purl.
+func scopeMatches(pattern string, v *v2entities.Vulnerability, target *v2entities.Target, component *v2entities.Component) bool {
+	var candidates []string
+	if v.VulnId != nil {
+		candidates = append(candidates, *v.VulnId)
+	}
+	if target != nil && target.Hostname != nil {
+		candidates = append(candidates, *target.Hostname)
+	}
+	if component != nil {
+		if component.Name != nil {
+			candidates = append(candidates, *component.Name)
+		}
+		if component.Purl != nil {
+			candidates = append(candidates, *component.Purl)
+		}
+	}
+
+	if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return false
+		}
+		for _, c := range candidates {
+			if re.MatchString(c) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, c := range candidates {
+		if ok, err := filepath.Match(pattern, c); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package suppress
+
+import v2entities "github.com/zavgorodnii/pentagi-taxonomy/v2/go/entities"
+
+// RiskAdjuster recomputes Target.RiskScore once suppressed vulnerabilities
+// have been removed from consideration.
+type RiskAdjuster struct{}
+
+// Adjust sets target.RiskScore to the highest CvssScore among remaining
+// (non-suppressed) vulnerabilities, or clears it if none remain.
+func (RiskAdjuster) Adjust(target *v2entities.Target, remaining []*v2entities.Vulnerability) {
+	var max float64
+	found := false
+	for _, v := range remaining {
+		if v.CvssScore == nil {
+			continue
+		}
+		if !found || *v.CvssScore > max {
+			max = *v.CvssScore
+			found = true
+		}
+	}
+
+	if !found {
+		target.RiskScore = nil
+		return
+	}
+	target.RiskScore = &max
+}
@@ -29,14 +29,28 @@ type Port struct {
 type Vulnerability struct {
 	Version *int `json:"version,omitempty"` // Taxonomy schema version (auto-injected by Graphiti fork)
 	EntityUuid *string `json:"entity_uuid,omitempty"` // Unique identifier
-	VulnId *string `json:"vuln_id,omitempty"` // Custom vulnerability identifier
+	VulnId *string `json:"vuln_id,omitempty" validate:"omitempty,cve_id"` // CVE identifier (CVE-YYYY-NNNN+)
 	Title *string `json:"title,omitempty"` // Vulnerability title
 	Severity *string `json:"severity,omitempty" validate:"omitempty,oneof=critical high medium low info"` // Severity classification
 	CvssScore *float64 `json:"cvss_score,omitempty" validate:"omitempty,min=0.0,max=10.0"` // CVSS score
+	CvssVector *string `json:"cvss_vector,omitempty" validate:"omitempty,cvss_vector"` // CVSS v3.x vector string
 	Exploitable *bool `json:"exploitable,omitempty"` // Whether the vulnerability is exploitable
 	DiscoveredAt *float64 `json:"discovered_at,omitempty"` // Discovery timestamp
 }
 
+// Component A software component discovered via SBOM ingestion
+type Component struct {
+	Version *int `json:"version,omitempty"` // Taxonomy schema version (auto-injected by Graphiti fork)
+	EntityUuid *string `json:"entity_uuid,omitempty"` // Unique identifier
+	Classifier *string `json:"classifier,omitempty" validate:"omitempty,oneof=application framework library container operating_system device firmware file"` // CycloneDX/SPDX component type
+	Name *string `json:"name,omitempty"` // Component name
+	ComponentVersion *string `json:"component_version,omitempty"` // Component version string
+	Purl *string `json:"purl,omitempty"` // Package URL
+	Cpe *string `json:"cpe,omitempty"` // CPE identifier
+	Hashes []string `json:"hashes,omitempty"` // Content hashes, formatted "alg:hex"
+	Licenses []string `json:"licenses,omitempty"` // SPDX license identifiers or expressions
+}
+
 // HasPort A target has a port
 type HasPort struct {
 	Version *int `json:"version,omitempty"` // Taxonomy schema version (auto-injected by Graphiti fork)
@@ -58,4 +72,28 @@ type Affects struct {
 	Impact *string `json:"impact,omitempty" validate:"omitempty,oneof=direct indirect"` // Type of impact
 }
 
+// DependsOn A component depends on another component
+type DependsOn struct {
+	Version *int `json:"version,omitempty"` // Taxonomy schema version (auto-injected by Graphiti fork)
+	Timestamp *float64 `json:"timestamp,omitempty"` // When the dependency was recorded
+}
+
+// VulnerabilityException An accepted-risk or false-positive suppression for matching vulnerabilities
+type VulnerabilityException struct {
+	Version *int `json:"version,omitempty"` // Taxonomy schema version (auto-injected by Graphiti fork)
+	EntityUuid *string `json:"entity_uuid,omitempty"` // Unique identifier
+	Reason *string `json:"reason,omitempty" validate:"omitempty,oneof=false_positive accepted_risk compensating_controls other"` // Why the exception was granted
+	Scope *string `json:"scope,omitempty"` // Glob or /regex/ pattern matched against a host, component, cve, or purl
+	Severities []string `json:"severities,omitempty" validate:"omitempty,dive,oneof=critical high medium low info"` // Severities this exception applies to; empty means all
+	FixableOnly *bool `json:"fixable_only,omitempty"` // If true, only suppresses vulnerabilities with an available fix
+	ExpiresAt *float64 `json:"expires_at,omitempty"` // When the exception stops applying
+	CreatedBy *string `json:"created_by,omitempty"` // Who granted the exception
+}
+
+// Suppresses An exception suppresses a vulnerability or an affects relationship
+type Suppresses struct {
+	Version *int `json:"version,omitempty"` // Taxonomy schema version (auto-injected by Graphiti fork)
+	Timestamp *float64 `json:"timestamp,omitempty"` // When the suppression was applied
+}
+
 
@@ -0,0 +1,106 @@
+// Auto-generated Graphiti node/edge bindings for pentagi-taxonomy.
+// DO NOT EDIT - this file is generated from entities.yml
+
+package entities
+
+import graph "github.com/zavgorodnii/pentagi-taxonomy/graph/go/graph"
+
+// Label implements graph.Node.
+func (e *Target) Label() string { return "Target" }
+
+// Props implements graph.Node.
+func (e *Target) Props() map[string]any { return graph.PropsFromTags(e) }
+
+// UUID implements graph.Node.
+func (e *Target) UUID() string {
+	if e.EntityUuid == nil {
+		return ""
+	}
+	return *e.EntityUuid
+}
+
+// Label implements graph.Node.
+func (e *Port) Label() string { return "Port" }
+
+// Props implements graph.Node.
+func (e *Port) Props() map[string]any { return graph.PropsFromTags(e) }
+
+// UUID implements graph.Node.
+func (e *Port) UUID() string {
+	if e.EntityUuid == nil {
+		return ""
+	}
+	return *e.EntityUuid
+}
+
+// Label implements graph.Node.
+func (e *Vulnerability) Label() string { return "Vulnerability" }
+
+// Props implements graph.Node.
+func (e *Vulnerability) Props() map[string]any { return graph.PropsFromTags(e) }
+
+// UUID implements graph.Node.
+func (e *Vulnerability) UUID() string {
+	if e.EntityUuid == nil {
+		return ""
+	}
+	return *e.EntityUuid
+}
+
+// Label implements graph.Node.
+func (e *Component) Label() string { return "Component" }
+
+// Props implements graph.Node.
+func (e *Component) Props() map[string]any { return graph.PropsFromTags(e) }
+
+// UUID implements graph.Node.
+func (e *Component) UUID() string {
+	if e.EntityUuid == nil {
+		return ""
+	}
+	return *e.EntityUuid
+}
+
+// Label implements graph.Node.
+func (e *VulnerabilityException) Label() string { return "VulnerabilityException" }
+
+// Props implements graph.Node.
+func (e *VulnerabilityException) Props() map[string]any { return graph.PropsFromTags(e) }
+
+// UUID implements graph.Node.
+func (e *VulnerabilityException) UUID() string {
+	if e.EntityUuid == nil {
+		return ""
+	}
+	return *e.EntityUuid
+}
+
+// Label implements graph.Edge.
+func (e *HasPort) Label() string { return "HAS_PORT" }
+
+// Props implements graph.Edge.
+func (e *HasPort) Props() map[string]any { return graph.PropsFromTags(e) }
+
+// Label implements graph.Edge.
+func (e *Discovered) Label() string { return "DISCOVERED" }
+
+// Props implements graph.Edge.
+func (e *Discovered) Props() map[string]any { return graph.PropsFromTags(e) }
+
+// Label implements graph.Edge.
+func (e *Affects) Label() string { return "AFFECTS" }
+
+// Props implements graph.Edge.
+func (e *Affects) Props() map[string]any { return graph.PropsFromTags(e) }
+
+// Label implements graph.Edge.
+func (e *DependsOn) Label() string { return "DEPENDS_ON" }
+
+// Props implements graph.Edge.
+func (e *DependsOn) Props() map[string]any { return graph.PropsFromTags(e) }
+
+// Label implements graph.Edge.
+func (e *Suppresses) Label() string { return "SUPPRESSES" }
+
+// Props implements graph.Edge.
+func (e *Suppresses) Props() map[string]any { return graph.PropsFromTags(e) }
@@ -4,6 +4,8 @@
 package entities
 
 import (
+	"regexp"
+
 	"github.com/go-playground/validator/v10"
 )
 
@@ -12,9 +14,27 @@ var Validator *validator.Validate
 
 func init() {
 	Validator = validator.New()
-	
+
 	// Register custom validators for complex regex patterns here
-	// Example: Validator.RegisterValidation("cve_id", cveIDValidator)
+	Validator.RegisterValidation("cve_id", cveIDValidator)
+	Validator.RegisterValidation("cvss_vector", cvssVectorValidator)
+}
+
+var cveIDPattern = regexp.MustCompile(`^CVE-\d{4}-\d{4,}$`)
+
+// cveIDValidator accepts MITRE CVE identifiers of the form CVE-YYYY-NNNN+
+// (four or more digits in the sequence number, per the 2014 CVE ID syntax
+// change).
+func cveIDValidator(fl validator.FieldLevel) bool {
+	return cveIDPattern.MatchString(fl.Field().String())
+}
+
+var cvssVectorPattern = regexp.MustCompile(`^CVSS:3\.[01](/[A-Z]{1,3}:[A-Z])+$`)
+
+// cvssVectorValidator accepts CVSS v3.x vector strings, e.g.
+// "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H".
+func cvssVectorValidator(fl validator.FieldLevel) bool {
+	return cvssVectorPattern.MatchString(fl.Field().String())
 }
 
 // Validate validates a Target entity
@@ -32,6 +52,11 @@ func (e *Vulnerability) Validate() error {
 	return Validator.Struct(e)
 }
 
+// Validate validates a Component entity
+func (e *Component) Validate() error {
+	return Validator.Struct(e)
+}
+
 // Validate validates a HasPort edge
 func (e *HasPort) Validate() error {
 	return Validator.Struct(e)
@@ -47,3 +72,18 @@ func (e *Affects) Validate() error {
 	return Validator.Struct(e)
 }
 
+// Validate validates a DependsOn edge
+func (e *DependsOn) Validate() error {
+	return Validator.Struct(e)
+}
+
+// Validate validates a VulnerabilityException entity
+func (e *VulnerabilityException) Validate() error {
+	return Validator.Struct(e)
+}
+
+// Validate validates a Suppresses edge
+func (e *Suppresses) Validate() error {
+	return Validator.Struct(e)
+}
+